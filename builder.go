@@ -0,0 +1,105 @@
+package rsocket
+
+import (
+	"crypto/tls"
+	"net/http"
+	"time"
+
+	"github.com/rsocket/rsocket-go/internal/socket"
+	"github.com/rsocket/rsocket-go/internal/transport"
+)
+
+type (
+	// ClientTransportOption is option for client-side transport.
+	ClientTransportOption func(*transport.URI)
+
+	// ServerTransportOption is option for server-side transport.
+	ServerTransportOption func(*transport.URI)
+
+	// ClientOption configures a client-side socket beyond its transport.
+	ClientOption func(*socket.Opts)
+
+	// ServerOption configures a server-side socket beyond its transport.
+	ServerOption func(*socket.Opts)
+
+	// ServerTransport is the server-side counterpart created from a transport
+	// URI, or mounted directly on an existing http.ServeMux.
+	ServerTransport = transport.ServerTransport
+
+	// ServerTransportFactory builds a ServerTransport directly, bypassing URI
+	// parsing entirely. Used e.g. by WithWebsocketMux to mount on an existing
+	// http.ServeMux instead of opening a new listener.
+	ServerTransportFactory func() (ServerTransport, error)
+)
+
+// WithWebsocketMux mounts the RSocket websocket endpoint on mux at path
+// instead of creating its own listener, so the caller can serve RSocket
+// alongside REST or health endpoints on one http.Server and port.
+func WithWebsocketMux(mux *http.ServeMux, path string) ServerTransportFactory {
+	return func() (ServerTransport, error) {
+		return transport.NewWebsocketServerTransportWithMux(mux, path), nil
+	}
+}
+
+// WithClientInterceptors installs an interceptor chain which every client
+// request (FireAndForget, MetadataPush, RequestResponse, RequestStream,
+// RequestChannel) is walked through before it reaches the wire.
+func WithClientInterceptors(interceptors ...socket.Interceptor) ClientOption {
+	return func(o *socket.Opts) {
+		o.Interceptors = append(o.Interceptors, interceptors...)
+	}
+}
+
+// WithServerInterceptors installs an interceptor chain which every inbound
+// request is walked through before it reaches the registered Responder.
+func WithServerInterceptors(interceptors ...socket.Interceptor) ServerOption {
+	return func(o *socket.Opts) {
+		o.Interceptors = append(o.Interceptors, interceptors...)
+	}
+}
+
+// WithTLSConfig installs a TLS config used when the client dials a tcps:// or
+// wss:// transport URI. It has no effect on plaintext transports.
+func WithTLSConfig(c *tls.Config) ClientTransportOption {
+	return func(u *transport.URI) {
+		u.SetTLSConfig(c)
+	}
+}
+
+// WithServerTLSConfig installs a TLS config used when the server listens on a
+// tcps:// or wss:// transport URI. It has no effect on plaintext transports.
+func WithServerTLSConfig(c *tls.Config) ServerTransportOption {
+	return func(u *transport.URI) {
+		u.SetTLSConfig(c)
+	}
+}
+
+// ResumeStore persists session state keyed by a client-presented resume
+// token, so a reconnecting client can rebind its in-flight RequestStream
+// subscriptions instead of losing them. See socket.NewInMemoryResumeStore for
+// the default, process-local implementation.
+type ResumeStore = socket.ResumeStore
+
+// NewInMemoryResumeStore returns the default ResumeStore, which keeps session
+// state in process memory and forgets it after ttl elapses.
+func NewInMemoryResumeStore(ttl time.Duration) ResumeStore {
+	return socket.NewInMemoryResumeStore(ttl)
+}
+
+// WithResume enables session resumption on the server: a reconnecting client
+// presenting a resume token found in store has its in-flight RequestStream
+// subscriptions rebound rather than errored. store's own TTL (see
+// NewInMemoryResumeStore) governs how long saved state survives.
+func WithResume(store ResumeStore) ServerOption {
+	return func(o *socket.Opts) {
+		o.ResumeStore = store
+	}
+}
+
+// WithResumeToken sets the resume token the client presents on (re)connect so
+// the server can rebind a prior session instead of starting a fresh one.
+func WithResumeToken(token []byte) ClientOption {
+	return func(o *socket.Opts) {
+		o.ResumeToken = token
+	}
+}
@@ -0,0 +1,65 @@
+package rsocket
+
+import (
+	"context"
+
+	"github.com/rsocket/rsocket-go/internal/socket"
+	"github.com/rsocket/rsocket-go/internal/transport"
+)
+
+// ClientBuilder builds and starts a RSocket client connection.
+type ClientBuilder struct {
+	uri           string
+	transportOpts []ClientTransportOption
+	opts          []ClientOption
+}
+
+// Connect creates a new ClientBuilder.
+func Connect() *ClientBuilder {
+	return &ClientBuilder{}
+}
+
+// Transport configures the client to dial uri (e.g. "tcp://127.0.0.1:7878"),
+// applying any ClientTransportOption (TLS, ...) to the parsed URI.
+func (b *ClientBuilder) Transport(uri string, opts ...ClientTransportOption) *ClientBuilder {
+	b.uri = uri
+	b.transportOpts = opts
+	return b
+}
+
+// Options installs cross-cutting ClientOptions (interceptors, a resume
+// token, ...) applied to the connected socket.
+func (b *ClientBuilder) Options(opts ...ClientOption) *ClientBuilder {
+	b.opts = append(b.opts, opts...)
+	return b
+}
+
+// Start dials the configured transport, performs the SETUP handshake, and
+// returns the resulting CloseableRSocket. A resume token set through Options
+// is presented to the server in the SETUP frame; the client itself keeps no
+// ResumeStore and rebinds no streams of its own.
+func (b *ClientBuilder) Start(ctx context.Context) (CloseableRSocket, error) {
+	u, err := transport.ParseURI(b.uri)
+	if err != nil {
+		return nil, err
+	}
+	for _, fn := range b.transportOpts {
+		fn(u)
+	}
+	tp, err := u.MakeClientTransport()
+	if err != nil {
+		return nil, err
+	}
+	var so socket.Opts
+	for _, fn := range b.opts {
+		fn(&so)
+	}
+	raw := socket.NewClientDuplexRSocket(tp, nil)
+	sk := socket.NewClientSocket(raw, so)
+	setup := &socket.SetupInfo{ResumeToken: so.ResumeToken}
+	if err := sk.Setup(ctx, setup); err != nil {
+		_ = sk.Close()
+		return nil, err
+	}
+	return sk, nil
+}
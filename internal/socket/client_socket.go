@@ -0,0 +1,28 @@
+package socket
+
+import "context"
+
+// clientSocket is the concrete ClientSocket returned by the rsocket package's
+// client builder.
+type clientSocket struct {
+	*baseSocket
+}
+
+// NewClientSocket wraps rawSocket as a ClientSocket, applying opts
+// (installed interceptors, a resume token, ...) to the underlying baseSocket
+// before any request is made.
+func NewClientSocket(rawSocket *DuplexRSocket, opts ...Opts) ClientSocket {
+	return &clientSocket{baseSocket: newBaseSocket(rawSocket, opts...)}
+}
+
+// Setup setups current socket. When the socket was constructed with a
+// ResumeStore that still holds state for its resume token, a successful
+// Setup rebinds the prior in-flight RequestStream subscriptions
+// it finds instead of leaving them lost.
+func (p *clientSocket) Setup(ctx context.Context, setup *SetupInfo) error {
+	if err := p.socket.Setup(ctx, setup); err != nil {
+		return err
+	}
+	p.loadResumeState()
+	return nil
+}
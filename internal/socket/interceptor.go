@@ -0,0 +1,79 @@
+package socket
+
+import (
+	"context"
+
+	"github.com/rsocket/rsocket-go/logger"
+)
+
+// FrameKind identifies which Responder interaction an Interceptor is wrapping.
+type FrameKind int8
+
+const (
+	// FrameFireAndForget marks a FireAndForget invocation.
+	FrameFireAndForget FrameKind = iota
+	// FrameMetadataPush marks a MetadataPush invocation.
+	FrameMetadataPush
+	// FrameRequestResponse marks a RequestResponse invocation.
+	FrameRequestResponse
+	// FrameRequestStream marks a RequestStream invocation.
+	FrameRequestStream
+	// FrameRequestChannel marks a RequestChannel invocation.
+	FrameRequestChannel
+)
+
+// Handler is the terminal function an Interceptor chain ultimately invokes.
+// msg is the incoming payload.Payload for FF/MP/RR/RS, or the rx.Publisher for RC.
+// The returned result is nil for FF/MP, a mono.Mono for RR, or a flux.Flux for
+// RS/RC.
+type Handler func(ctx context.Context, msg interface{}) (result interface{}, err error)
+
+// Interceptor wraps a Handler to add cross-cutting behavior (auth, tracing,
+// metrics, rate-limiting, panic-recovery, ...) around a Responder invocation.
+// Implementations must call next to continue the chain.
+type Interceptor func(ctx context.Context, kind FrameKind, msg interface{}, next Handler) (result interface{}, err error)
+
+// Opts collects cross-cutting configuration applied to a baseSocket.
+type Opts struct {
+	Interceptors []Interceptor
+	// ResumeStore configures session resumption. Its own TTL (see
+	// NewInMemoryResumeStore) governs how long saved state survives.
+	ResumeStore ResumeStore
+	// ResumeToken is the token a client presents to resume a prior session.
+	ResumeToken []byte
+}
+
+// leaseInterceptor is the built-in interceptor enforcing the request lease.
+// It always runs first so the leaser, tracing, and user policies compose
+// uniformly via the same chain.
+func (p *baseSocket) leaseInterceptor(ctx context.Context, kind FrameKind, msg interface{}, next Handler) (interface{}, error) {
+	switch kind {
+	case FrameMetadataPush:
+		return next(ctx, msg)
+	case FrameFireAndForget:
+		if err := p.reqLease.allow(); err != nil {
+			logger.Warnf("request FireAndForget failed: %v\n", err)
+		}
+		return next(ctx, msg)
+	default:
+		if err := p.reqLease.allow(); err != nil {
+			return nil, err
+		}
+		return next(ctx, msg)
+	}
+}
+
+// invoke walks the interceptor chain, built-in leaseInterceptor first, then
+// any user-installed interceptors, before delegating to terminal.
+func (p *baseSocket) invoke(ctx context.Context, kind FrameKind, msg interface{}, terminal Handler) (interface{}, error) {
+	h := terminal
+	chain := append([]Interceptor{p.leaseInterceptor}, p.interceptors...)
+	for i := len(chain) - 1; i >= 0; i-- {
+		ic := chain[i]
+		next := h
+		h = func(ctx context.Context, msg interface{}) (interface{}, error) {
+			return ic(ctx, kind, msg, next)
+		}
+	}
+	return h(ctx, msg)
+}
@@ -0,0 +1,102 @@
+package socket
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rsocket/rsocket-go/payload"
+)
+
+// errResumeTokenNotFound is returned by ResumeStore.Load when a token is
+// unknown or has expired.
+var errResumeTokenNotFound = errors.New("resume: token not found or expired")
+
+// StreamDescriptor is a replayable record of one still-open RequestStream
+// subscription, captured at the moment the transport dropped, so it can be
+// reissued against a reconnecting client's new connection instead of left
+// erroring out. Only RequestStream is replayable this way: a RequestChannel's
+// inbound publisher is a handle bound to the dead connection that produced
+// it and cannot be reconstructed once that connection is gone, so in-flight
+// RequestChannel subscriptions are tracked for leak-free bookkeeping but are
+// dropped, not replayed, on resume (see rebindStreams).
+type StreamDescriptor struct {
+	// Kind is FrameRequestStream or FrameRequestChannel.
+	Kind FrameKind
+	// Message is the original request payload. Only set, and only replayed,
+	// when Kind is FrameRequestStream.
+	Message payload.Payload
+}
+
+// ResumeState captures the in-flight stream state needed to rebind a
+// reconnecting client to its prior session, rather than erroring out every
+// outstanding RequestStream subscription.
+type ResumeState struct {
+	// Streams holds a StreamDescriptor per still-open subscription, keyed by
+	// an ID local to the baseSocket that issued or is serving it.
+	Streams map[uint32]StreamDescriptor
+}
+
+// ResumeStore persists ResumeState keyed by a client-presented resume token.
+// The accept path consults it when a client reconnects: a hit within the
+// configured TTL rebinds the prior DuplexRSocket's RequestStream
+// subscriptions instead of erroring them. ResumeState holds only plain,
+// serializable data (a FrameKind and a payload.Payload per stream), so a
+// ResumeStore is free to persist it outside this process; the default
+// NewInMemoryResumeStore simply keeps it in memory for a single process.
+type ResumeStore interface {
+	// Save persists state under token.
+	Save(token []byte, state ResumeState) error
+	// Load retrieves the state previously saved under token.
+	Load(token []byte) (state ResumeState, err error)
+	// Evict removes any state saved under token.
+	Evict(token []byte)
+}
+
+type resumeEntry struct {
+	state   ResumeState
+	expires time.Time
+}
+
+type inMemoryResumeStore struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]resumeEntry
+}
+
+// NewInMemoryResumeStore returns a ResumeStore which keeps session state in
+// process memory and forgets it after ttl elapses.
+func NewInMemoryResumeStore(ttl time.Duration) ResumeStore {
+	return &inMemoryResumeStore{
+		ttl:     ttl,
+		entries: make(map[string]resumeEntry),
+	}
+}
+
+func (s *inMemoryResumeStore) Save(token []byte, state ResumeState) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[string(token)] = resumeEntry{
+		state:   state,
+		expires: time.Now().Add(s.ttl),
+	}
+	return nil
+}
+
+func (s *inMemoryResumeStore) Load(token []byte) (ResumeState, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := string(token)
+	e, ok := s.entries[key]
+	if !ok || time.Now().After(e.expires) {
+		delete(s.entries, key)
+		return ResumeState{}, errResumeTokenNotFound
+	}
+	return e.state, nil
+}
+
+func (s *inMemoryResumeStore) Evict(token []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, string(token))
+}
@@ -0,0 +1,51 @@
+package socket
+
+import (
+	"context"
+
+	"github.com/rsocket/rsocket-go/internal/transport"
+)
+
+// serverSocket is the concrete ServerSocket returned by the rsocket package's
+// server builder.
+type serverSocket struct {
+	*baseSocket
+	responder Responder
+	tp        *transport.Transport
+	paused    bool
+}
+
+// NewServerSocket wraps rawSocket as a ServerSocket, applying opts (installed
+// interceptors, a resume store, a resume token parsed from the client's
+// SETUP frame, ...) to the underlying baseSocket. When opts carries a
+// ResumeStore and a resume token with a hit still within its TTL, Start
+// rebinds the prior in-flight RequestStream subscriptions it finds instead
+// of leaving them lost.
+func NewServerSocket(rawSocket *DuplexRSocket, opts ...Opts) ServerSocket {
+	return &serverSocket{baseSocket: newBaseSocket(rawSocket, opts...)}
+}
+
+func (p *serverSocket) SetResponder(responder Responder) {
+	p.responder = responder
+}
+
+func (p *serverSocket) SetTransport(tp *transport.Transport) {
+	p.tp = tp
+}
+
+func (p *serverSocket) Pause() bool {
+	p.paused = true
+	return p.paused
+}
+
+func (p *serverSocket) Start(ctx context.Context) error {
+	if err := p.socket.Start(ctx); err != nil {
+		return err
+	}
+	p.loadResumeState()
+	return nil
+}
+
+func (p *serverSocket) Token() (token []byte, ok bool) {
+	return p.resumeToken, len(p.resumeToken) > 0
+}
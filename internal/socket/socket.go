@@ -120,10 +120,86 @@ func (p AbstractRSocket) RequestChannel(messages rx.Publisher) flux.Flux {
 }
 
 type baseSocket struct {
-	socket   *DuplexRSocket
-	closers  []func(error)
-	once     sync.Once
-	reqLease *leaser
+	socket       *DuplexRSocket
+	closers      []func(error)
+	once         sync.Once
+	reqLease     *leaser
+	interceptors []Interceptor
+	resumeStore  ResumeStore
+	resumeToken  []byte
+
+	streamsMu    sync.Mutex
+	streams      map[uint32]StreamDescriptor
+	nextStreamID uint32
+}
+
+// trackStream records an outstanding RequestStream/RequestChannel invocation
+// so it can be replayed from a ResumeStore if the transport drops before it
+// completes. It returns an ID that the caller must untrack, via
+// untrackStream, once the stream reaches a terminal state.
+func (p *baseSocket) trackStream(desc StreamDescriptor) uint32 {
+	p.streamsMu.Lock()
+	defer p.streamsMu.Unlock()
+	if p.streams == nil {
+		p.streams = make(map[uint32]StreamDescriptor)
+	}
+	p.nextStreamID++
+	id := p.nextStreamID
+	p.streams[id] = desc
+	return id
+}
+
+func (p *baseSocket) untrackStream(id uint32) {
+	p.streamsMu.Lock()
+	defer p.streamsMu.Unlock()
+	delete(p.streams, id)
+}
+
+// rebindStreams replays every replayable StreamDescriptor loaded from a
+// ResumeStore against the current socket, reattaching the reconnecting
+// client to its prior RequestStream subscriptions instead of leaving them
+// errored. RequestChannel entries can't be replayed (see StreamDescriptor)
+// and are simply dropped.
+func (p *baseSocket) rebindStreams(state ResumeState) {
+	for id, desc := range state.Streams {
+		if desc.Kind != FrameRequestStream {
+			logger.Warnf("resume: dropping in-flight RequestChannel %d, cannot survive a reconnect\n", id)
+			continue
+		}
+		p.RequestStream(desc.Message)
+		logger.Warnf("resume: rebound stream %d\n", id)
+	}
+}
+
+// loadResumeState consults resumeStore for resumeToken and, on a hit, rebinds
+// the previously in-flight streams it recorded instead of letting the caller
+// discover them as errored. Callers must invoke this only once the socket's
+// new transport is actually up (e.g. after Setup/Start succeeds), since
+// rebound requests are sent over it immediately.
+func (p *baseSocket) loadResumeState() {
+	if p.resumeStore == nil || len(p.resumeToken) == 0 {
+		return
+	}
+	state, err := p.resumeStore.Load(p.resumeToken)
+	if err != nil {
+		return
+	}
+	p.rebindStreams(state)
+}
+
+func (p *baseSocket) saveResumeState() {
+	if p.resumeStore == nil || len(p.resumeToken) == 0 {
+		return
+	}
+	p.streamsMu.Lock()
+	state := ResumeState{Streams: make(map[uint32]StreamDescriptor, len(p.streams))}
+	for id, desc := range p.streams {
+		state.Streams[id] = desc
+	}
+	p.streamsMu.Unlock()
+	if err := p.resumeStore.Save(p.resumeToken, state); err != nil {
+		logger.Warnf("save resume state failed: %v\n", err)
+	}
 }
 
 func (p *baseSocket) refreshLease(ttl time.Duration, n int64) {
@@ -136,35 +212,75 @@ func (p *baseSocket) refreshLease(ttl time.Duration, n int64) {
 }
 
 func (p *baseSocket) FireAndForget(message payload.Payload) {
-	if err := p.reqLease.allow(); err != nil {
-		logger.Warnf("request FireAndForget failed: %v\n", err)
-	}
-	p.socket.FireAndForget(message)
+	_, _ = p.invoke(context.Background(), FrameFireAndForget, message, func(_ context.Context, msg interface{}) (interface{}, error) {
+		p.socket.FireAndForget(msg.(payload.Payload))
+		return nil, nil
+	})
 }
 
 func (p *baseSocket) MetadataPush(message payload.Payload) {
-	p.socket.MetadataPush(message)
+	_, _ = p.invoke(context.Background(), FrameMetadataPush, message, func(_ context.Context, msg interface{}) (interface{}, error) {
+		p.socket.MetadataPush(msg.(payload.Payload))
+		return nil, nil
+	})
 }
 
 func (p *baseSocket) RequestResponse(message payload.Payload) mono.Mono {
-	if err := p.reqLease.allow(); err != nil {
+	res, err := p.invoke(context.Background(), FrameRequestResponse, message, func(_ context.Context, msg interface{}) (interface{}, error) {
+		return p.socket.RequestResponse(msg.(payload.Payload)), nil
+	})
+	if err != nil {
 		return mono.Error(err)
 	}
-	return p.socket.RequestResponse(message)
+	m, ok := res.(mono.Mono)
+	if !ok {
+		// An interceptor short-circuited the chain without returning a mono.Mono,
+		// e.g. a panic-recovery or auth interceptor rejecting the request outright.
+		return mono.Error(errUnsupportedRequestResponse)
+	}
+	return m
 }
 
 func (p *baseSocket) RequestStream(message payload.Payload) flux.Flux {
-	if err := p.reqLease.allow(); err != nil {
+	id := p.trackStream(StreamDescriptor{Kind: FrameRequestStream, Message: message})
+	res, err := p.invoke(context.Background(), FrameRequestStream, message, func(_ context.Context, msg interface{}) (interface{}, error) {
+		return p.socket.RequestStream(msg.(payload.Payload)), nil
+	})
+	if err != nil {
+		p.untrackStream(id)
 		return flux.Error(err)
 	}
-	return p.socket.RequestStream(message)
+	f, ok := res.(flux.Flux)
+	if !ok {
+		// An interceptor short-circuited the chain without returning a flux.Flux,
+		// e.g. a panic-recovery or auth interceptor rejecting the request outright.
+		p.untrackStream(id)
+		return flux.Error(errUnsupportedRequestStream)
+	}
+	return f.DoFinally(func(rx.SignalType) {
+		p.untrackStream(id)
+	})
 }
 
 func (p *baseSocket) RequestChannel(messages rx.Publisher) flux.Flux {
-	if err := p.reqLease.allow(); err != nil {
+	id := p.trackStream(StreamDescriptor{Kind: FrameRequestChannel})
+	res, err := p.invoke(context.Background(), FrameRequestChannel, messages, func(_ context.Context, msg interface{}) (interface{}, error) {
+		return p.socket.RequestChannel(msg.(rx.Publisher)), nil
+	})
+	if err != nil {
+		p.untrackStream(id)
 		return flux.Error(err)
 	}
-	return p.socket.RequestChannel(messages)
+	f, ok := res.(flux.Flux)
+	if !ok {
+		// An interceptor short-circuited the chain without returning a flux.Flux,
+		// e.g. a panic-recovery or auth interceptor rejecting the request outright.
+		p.untrackStream(id)
+		return flux.Error(errUnsupportedRequestChannel)
+	}
+	return f.DoFinally(func(rx.SignalType) {
+		p.untrackStream(id)
+	})
 }
 
 func (p *baseSocket) OnClose(fn func(error)) {
@@ -175,6 +291,7 @@ func (p *baseSocket) OnClose(fn func(error)) {
 
 func (p *baseSocket) Close() (err error) {
 	p.once.Do(func() {
+		p.saveResumeState()
 		err = p.socket.Close()
 		for i, l := 0, len(p.closers); i < l; i++ {
 			func(fn func(error)) {
@@ -190,8 +307,22 @@ func (p *baseSocket) Close() (err error) {
 	return
 }
 
-func newBaseSocket(rawSocket *DuplexRSocket) *baseSocket {
-	return &baseSocket{
+// newBaseSocket assembles a baseSocket from opts. It deliberately does not
+// call loadResumeState itself: the new transport isn't up yet at this point,
+// and rebound requests are sent immediately, so callers must do that once
+// their own handshake (Setup, Start, ...) succeeds instead.
+func newBaseSocket(rawSocket *DuplexRSocket, opts ...Opts) *baseSocket {
+	sk := &baseSocket{
 		socket: rawSocket,
 	}
+	for _, o := range opts {
+		sk.interceptors = append(sk.interceptors, o.Interceptors...)
+		if o.ResumeStore != nil {
+			sk.resumeStore = o.ResumeStore
+		}
+		if len(o.ResumeToken) > 0 {
+			sk.resumeToken = o.ResumeToken
+		}
+	}
+	return sk
 }
@@ -0,0 +1,83 @@
+package transport
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+)
+
+// newTCPClientTransport creates a new plain TCP client-side transport.
+func newTCPClientTransport(addr string) (*Transport, error) {
+	return newTCPClientTransportTLS(addr, nil)
+}
+
+// newTCPClientTransportTLS creates a new TCP client-side transport, optionally
+// upgrading the dial to TLS when cfg is non-nil.
+func newTCPClientTransportTLS(addr string, cfg *tls.Config) (*Transport, error) {
+	var conn net.Conn
+	var err error
+	if cfg == nil {
+		conn, err = net.Dial("tcp", addr)
+	} else {
+		conn, err = tls.Dial("tcp", addr, cfg)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Transport{conn: conn}, nil
+}
+
+// newTCPServerTransport creates a new plain TCP server-side transport.
+func newTCPServerTransport(addr string) ServerTransport {
+	return newTCPServerTransportTLS(addr, nil)
+}
+
+// newTCPServerTransportTLS creates a new TCP server-side transport, optionally
+// serving TLS connections when cfg is non-nil.
+func newTCPServerTransportTLS(addr string, cfg *tls.Config) ServerTransport {
+	return &tcpServerTransport{addr: addr, tlsConfig: cfg}
+}
+
+type tcpServerTransport struct {
+	addr      string
+	tlsConfig *tls.Config
+	listener  net.Listener
+	acceptor  func(ctx context.Context, tp *Transport)
+}
+
+func (p *tcpServerTransport) Accept(acceptor func(ctx context.Context, tp *Transport)) {
+	p.acceptor = acceptor
+}
+
+func (p *tcpServerTransport) Listen(ctx context.Context, onReady chan<- struct{}) error {
+	var lis net.Listener
+	var err error
+	if p.tlsConfig == nil {
+		lis, err = net.Listen("tcp", p.addr)
+	} else {
+		lis, err = tls.Listen("tcp", p.addr, p.tlsConfig)
+	}
+	if err != nil {
+		return err
+	}
+	p.listener = lis
+	if onReady != nil {
+		close(onReady)
+	}
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			return err
+		}
+		if p.acceptor != nil {
+			go p.acceptor(ctx, &Transport{conn: conn})
+		}
+	}
+}
+
+func (p *tcpServerTransport) Close() error {
+	if p.listener == nil {
+		return nil
+	}
+	return p.listener.Close()
+}
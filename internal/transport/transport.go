@@ -0,0 +1,28 @@
+package transport
+
+import (
+	"context"
+	"io"
+)
+
+// Transport is a wrapper of a connection between client and server.
+type Transport struct {
+	conn io.ReadWriteCloser
+}
+
+// Close closes current transport.
+func (p *Transport) Close() error {
+	return p.conn.Close()
+}
+
+// ServerTransport is server-side transport which is responsible for accepting
+// incoming connections and handing them off to an acceptor.
+type ServerTransport interface {
+	// Accept registers a handler which will be invoked for every accepted connection.
+	Accept(acceptor func(ctx context.Context, tp *Transport))
+	// Listen starts listening for incoming connections.
+	// The onReady channel, if non-nil, is closed once the listener is up and running.
+	Listen(ctx context.Context, onReady chan<- struct{}) error
+	// Close closes the server transport and stops accepting new connections.
+	Close() error
+}
@@ -0,0 +1,59 @@
+package transport
+
+import (
+	"context"
+	"net"
+)
+
+// newUnixClientTransport creates a new client-side transport over a Unix
+// domain socket.
+func newUnixClientTransport(sockPath string) (*Transport, error) {
+	conn, err := net.Dial("unix", sockPath)
+	if err != nil {
+		return nil, err
+	}
+	return &Transport{conn: conn}, nil
+}
+
+// newUnixServerTransport creates a new server-side transport over a Unix
+// domain socket.
+func newUnixServerTransport(sockPath string) ServerTransport {
+	return &unixServerTransport{sockPath: sockPath}
+}
+
+type unixServerTransport struct {
+	sockPath string
+	listener net.Listener
+	acceptor func(ctx context.Context, tp *Transport)
+}
+
+func (p *unixServerTransport) Accept(acceptor func(ctx context.Context, tp *Transport)) {
+	p.acceptor = acceptor
+}
+
+func (p *unixServerTransport) Listen(ctx context.Context, onReady chan<- struct{}) error {
+	lis, err := net.Listen("unix", p.sockPath)
+	if err != nil {
+		return err
+	}
+	p.listener = lis
+	if onReady != nil {
+		close(onReady)
+	}
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			return err
+		}
+		if p.acceptor != nil {
+			go p.acceptor(ctx, &Transport{conn: conn})
+		}
+	}
+}
+
+func (p *unixServerTransport) Close() error {
+	if p.listener == nil {
+		return nil
+	}
+	return p.listener.Close()
+}
@@ -1,6 +1,7 @@
 package transport
 
 import (
+	"crypto/tls"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -9,37 +10,62 @@ import (
 const (
 	_ protocol = iota
 	protoTCP
+	protoTCPS
 	protoWebsocket
+	protoWebsocketS
+	protoUnix
 )
 
 var (
-	regURI = regexp.MustCompile("^(tcp://|ws://)?([^/:]+):([1-9][0-9]+)$")
+	regURI     = regexp.MustCompile("^(tcp://|tcps://|ws://|wss://)?([^/:]+):([1-9][0-9]+)(/.*)?$")
+	regUnixURI = regexp.MustCompile("^unix://(/.+)$")
 
 	protoMap = map[protocol]string{
-		protoTCP:       "tcp",
-		protoWebsocket: "ws",
+		protoTCP:        "tcp",
+		protoTCPS:       "tcps",
+		protoWebsocket:  "ws",
+		protoWebsocketS: "wss",
+		protoUnix:       "unix",
 	}
 )
 
 // URI is used to create a RSocket transport.
 type URI struct {
-	proto protocol
-	host  string
-	port  int
+	proto     protocol
+	host      string
+	port      int
+	path      string
+	tlsConfig *tls.Config
 }
 
 func (p *URI) String() string {
+	if p.proto == protoUnix {
+		return fmt.Sprintf("URI{protocol=%s, path=%s}", p.proto, p.path)
+	}
 	return fmt.Sprintf("URI{protocol=%s, host=%s, port=%d}", p.proto, p.host, p.port)
 }
 
+// SetTLSConfig installs a TLS config used when dialing or listening on a
+// tcps:// or wss:// transport. It is a no-op for plaintext protocols.
+func (p *URI) SetTLSConfig(c *tls.Config) {
+	p.tlsConfig = c
+}
+
 // MakeClientTransport creates a new client-side transport.
 func (p *URI) MakeClientTransport() (*Transport, error) {
 	switch p.proto {
 	case protoTCP:
 		return newTCPClientTransport(fmt.Sprintf("%s:%d", p.host, p.port))
+	case protoTCPS:
+		return newTCPClientTransportTLS(fmt.Sprintf("%s:%d", p.host, p.port), p.tlsConfig)
 	case protoWebsocket:
 		url := fmt.Sprintf("%s://%s:%d/", p.proto, p.host, p.port)
 		return newWebsocketClientTransport(url)
+	case protoWebsocketS:
+		url := fmt.Sprintf("%s://%s:%d/", p.proto, p.host, p.port)
+		return newWebsocketClientTransportTLS(url, p.tlsConfig)
+	case protoUnix:
+		return newUnixClientTransport(p.path)
 	}
 	return nil, fmt.Errorf("rsocket: cannot create client transport")
 }
@@ -50,9 +76,14 @@ func (p *URI) MakeServerTransport() (tp ServerTransport, err error) {
 	switch p.proto {
 	case protoTCP:
 		tp = newTCPServerTransport(addr)
+	case protoTCPS:
+		tp = newTCPServerTransportTLS(addr, p.tlsConfig)
 	case protoWebsocket:
-		// TODO: parse path
-		tp = newWebsocketServerTransport(addr, defaultWebsocketPath)
+		tp = newWebsocketServerTransport(addr, p.path)
+	case protoWebsocketS:
+		tp = newWebsocketServerTransportTLS(addr, p.path, p.tlsConfig)
+	case protoUnix:
+		tp = newUnixServerTransport(p.path)
 	default:
 		err = fmt.Errorf("rsocket: unsupported proto %s", p.proto)
 	}
@@ -71,6 +102,12 @@ func (s protocol) String() string {
 
 // ParseURI parse URI string and returns a URI.
 func ParseURI(uri string) (*URI, error) {
+	if mat := regUnixURI.FindStringSubmatch(uri); mat != nil {
+		return &URI{
+			proto: protoUnix,
+			path:  mat[1],
+		}, nil
+	}
 	mat := regURI.FindStringSubmatch(uri)
 	if mat == nil {
 		return nil, fmt.Errorf("rsocket: invalid URI %s", uri)
@@ -78,6 +115,7 @@ func ParseURI(uri string) (*URI, error) {
 	proto := mat[1]
 	host := mat[2]
 	port, _ := strconv.Atoi(mat[3])
+	path := mat[4]
 	switch proto {
 	case "tcp://", "":
 		return &URI{
@@ -85,11 +123,25 @@ func ParseURI(uri string) (*URI, error) {
 			host:  host,
 			port:  port,
 		}, nil
+	case "tcps://":
+		return &URI{
+			proto: protoTCPS,
+			host:  host,
+			port:  port,
+		}, nil
 	case "ws://":
 		return &URI{
 			proto: protoWebsocket,
 			host:  host,
 			port:  port,
+			path:  path,
+		}, nil
+	case "wss://":
+		return &URI{
+			proto: protoWebsocketS,
+			host:  host,
+			port:  port,
+			path:  path,
 		}, nil
 	default:
 		return nil, fmt.Errorf("rsocket: unsupported protocol %s", proto)
@@ -0,0 +1,180 @@
+package transport
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+
+	"github.com/gorilla/websocket"
+)
+
+const defaultWebsocketPath = "/"
+
+// wsConn adapts a *websocket.Conn to io.ReadWriteCloser in terms of gorilla's
+// own message framing, instead of reaching past it to the raw upgraded
+// socket: every Write is sent as one binary WS message, and Read drains one
+// WS message at a time across calls, so frame boundaries survive the
+// upgrade. conn.UnderlyingConn() would hand back the bare TCP/TLS stream,
+// bypassing that framing entirely.
+type wsConn struct {
+	conn   *websocket.Conn
+	reader io.Reader
+}
+
+func (c *wsConn) Read(b []byte) (int, error) {
+	for {
+		if c.reader == nil {
+			_, r, err := c.conn.NextReader()
+			if err != nil {
+				return 0, err
+			}
+			c.reader = r
+		}
+		n, err := c.reader.Read(b)
+		if err == io.EOF {
+			c.reader = nil
+			if n > 0 {
+				return n, nil
+			}
+			continue
+		}
+		return n, err
+	}
+}
+
+func (c *wsConn) Write(b []byte) (int, error) {
+	if err := c.conn.WriteMessage(websocket.BinaryMessage, b); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+func (c *wsConn) Close() error {
+	return c.conn.Close()
+}
+
+// newWebsocketClientTransport creates a new plain websocket client-side transport.
+func newWebsocketClientTransport(url string) (*Transport, error) {
+	return newWebsocketClientTransportTLS(url, nil)
+}
+
+// newWebsocketClientTransportTLS creates a new websocket client-side transport,
+// optionally dialing through cfg when the URL scheme is wss:// (cfg non-nil).
+func newWebsocketClientTransportTLS(url string, cfg *tls.Config) (*Transport, error) {
+	dialer := *websocket.DefaultDialer
+	if cfg != nil {
+		dialer.TLSClientConfig = cfg
+	}
+	conn, _, err := dialer.Dial(url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Transport{conn: &wsConn{conn: conn}}, nil
+}
+
+// newWebsocketServerTransport creates a new plain websocket server-side transport
+// which owns and listens on its own HTTP server.
+func newWebsocketServerTransport(addr string, path string) ServerTransport {
+	return newWebsocketServerTransportTLS(addr, path, nil)
+}
+
+// newWebsocketServerTransportTLS creates a new websocket server-side transport,
+// optionally serving TLS connections when cfg is non-nil.
+func newWebsocketServerTransportTLS(addr string, path string, cfg *tls.Config) ServerTransport {
+	if path == "" {
+		path = defaultWebsocketPath
+	}
+	tp := &websocketServerTransport{
+		addr:      addr,
+		path:      path,
+		mux:       http.NewServeMux(),
+		tlsConfig: cfg,
+		upgrader:  websocket.Upgrader{},
+	}
+	tp.mux.HandleFunc(path, tp.handle)
+	return tp
+}
+
+// NewWebsocketServerTransportWithMux mounts the RSocket websocket endpoint on
+// an existing http.ServeMux at path, instead of opening its own listener. This
+// lets the caller serve mux from its own http.Server, with other routes
+// (REST, health checks, ...) on the same port. Close on the returned
+// transport does not unregister the handler from mux, so upgrades at path
+// are still accepted afterwards; its Listen returns only once ctx is done.
+func NewWebsocketServerTransportWithMux(mux *http.ServeMux, path string) ServerTransport {
+	if path == "" {
+		path = defaultWebsocketPath
+	}
+	tp := &websocketServerTransport{
+		path:     path,
+		mux:      mux,
+		upgrader: websocket.Upgrader{},
+		external: true,
+	}
+	mux.HandleFunc(path, tp.handle)
+	return tp
+}
+
+type websocketServerTransport struct {
+	addr      string
+	path      string
+	mux       *http.ServeMux
+	tlsConfig *tls.Config
+	upgrader  websocket.Upgrader
+	server    *http.Server
+	acceptor  func(ctx context.Context, tp *Transport)
+	// external is true when the handler is mounted on a mux owned and served
+	// by the caller, so Listen must not start its own http.Server.
+	external bool
+}
+
+func (p *websocketServerTransport) Accept(acceptor func(ctx context.Context, tp *Transport)) {
+	p.acceptor = acceptor
+}
+
+func (p *websocketServerTransport) handle(w http.ResponseWriter, r *http.Request) {
+	conn, err := p.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	if p.acceptor != nil {
+		go p.acceptor(r.Context(), &Transport{conn: &wsConn{conn: conn}})
+	}
+}
+
+func (p *websocketServerTransport) Listen(ctx context.Context, onReady chan<- struct{}) error {
+	if p.external {
+		// The mux is served by the caller's own http.Server; there is nothing
+		// to listen on here beyond waiting for shutdown.
+		if onReady != nil {
+			close(onReady)
+		}
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	p.server = &http.Server{
+		Addr:      p.addr,
+		Handler:   p.mux,
+		TLSConfig: p.tlsConfig,
+	}
+	lis, err := net.Listen("tcp", p.addr)
+	if err != nil {
+		return err
+	}
+	if onReady != nil {
+		close(onReady)
+	}
+	if p.tlsConfig != nil {
+		return p.server.ServeTLS(lis, "", "")
+	}
+	return p.server.Serve(lis)
+}
+
+func (p *websocketServerTransport) Close() error {
+	if p.server == nil {
+		return nil
+	}
+	return p.server.Close()
+}
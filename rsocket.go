@@ -34,6 +34,29 @@ type (
 
 	// OptAbstractSocket is option for abstract socket.
 	OptAbstractSocket func(*socket.AbstractRSocket)
+
+	// FrameKind identifies which Responder interaction an Interceptor is wrapping.
+	FrameKind = socket.FrameKind
+
+	// Handler is the terminal function an Interceptor chain ultimately invokes.
+	Handler = socket.Handler
+
+	// Interceptor wraps a Handler to add cross-cutting behavior (auth, tracing,
+	// metrics, rate-limiting, panic-recovery, ...) around a Responder invocation.
+	Interceptor = socket.Interceptor
+)
+
+const (
+	// FrameFireAndForget marks a FireAndForget invocation.
+	FrameFireAndForget = socket.FrameFireAndForget
+	// FrameMetadataPush marks a MetadataPush invocation.
+	FrameMetadataPush = socket.FrameMetadataPush
+	// FrameRequestResponse marks a RequestResponse invocation.
+	FrameRequestResponse = socket.FrameRequestResponse
+	// FrameRequestStream marks a RequestStream invocation.
+	FrameRequestStream = socket.FrameRequestStream
+	// FrameRequestChannel marks a RequestChannel invocation.
+	FrameRequestChannel = socket.FrameRequestChannel
 )
 
 // NewAbstractSocket returns an abstract implementation of RSocket.
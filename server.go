@@ -0,0 +1,104 @@
+package rsocket
+
+import (
+	"context"
+
+	"github.com/rsocket/rsocket-go/internal/socket"
+	"github.com/rsocket/rsocket-go/internal/transport"
+)
+
+// ServerBuilder builds and serves a RSocket server.
+type ServerBuilder struct {
+	uri           string
+	transportOpts []ServerTransportOption
+	factory       ServerTransportFactory
+	opts          []ServerOption
+}
+
+// Receive creates a new ServerBuilder.
+func Receive() *ServerBuilder {
+	return &ServerBuilder{}
+}
+
+// Transport configures the server to listen on uri (e.g. "tcp://0.0.0.0:7878"),
+// applying any ServerTransportOption (TLS, ...) to the parsed URI.
+func (b *ServerBuilder) Transport(uri string, opts ...ServerTransportOption) *ServerBuilder {
+	b.uri = uri
+	b.transportOpts = opts
+	return b
+}
+
+// TransportFactory configures the server to serve the ServerTransport built
+// directly by factory, bypassing URI parsing entirely. Use this with
+// WithWebsocketMux to mount RSocket on an existing http.ServeMux/http.Server.
+func (b *ServerBuilder) TransportFactory(factory ServerTransportFactory) *ServerBuilder {
+	b.factory = factory
+	return b
+}
+
+// Options installs cross-cutting ServerOptions (interceptors, resume, ...)
+// applied to every accepted connection.
+func (b *ServerBuilder) Options(opts ...ServerOption) *ServerBuilder {
+	b.opts = append(b.opts, opts...)
+	return b
+}
+
+// Serve builds the configured transport, accepts connections, and hands each
+// one to acceptor. It returns when the transport's Listen returns: for the
+// URI-built transports that is when the listener fails or is closed (ctx
+// being done does not close it); for a WithWebsocketMux transport it is when
+// ctx is done.
+func (b *ServerBuilder) Serve(ctx context.Context, acceptor ServerAcceptor) error {
+	tp, err := b.buildTransport()
+	if err != nil {
+		return err
+	}
+	var so socket.Opts
+	for _, fn := range b.opts {
+		fn(&so)
+	}
+	tp.Accept(func(ctx context.Context, conn *transport.Transport) {
+		b.serveConnection(ctx, conn, so, acceptor)
+	})
+	onReady := make(chan struct{})
+	return tp.Listen(ctx, onReady)
+}
+
+func (b *ServerBuilder) buildTransport() (ServerTransport, error) {
+	if b.factory != nil {
+		return b.factory()
+	}
+	u, err := transport.ParseURI(b.uri)
+	if err != nil {
+		return nil, err
+	}
+	for _, fn := range b.transportOpts {
+		fn(u)
+	}
+	return u.MakeServerTransport()
+}
+
+// serveConnection performs the SETUP handshake on conn, then builds and runs
+// a ServerSocket for it. The client's SETUP frame is parsed before the
+// socket is constructed, so a presented resume token reaches so.ResumeToken
+// in time for Start to rebind against it.
+func (b *ServerBuilder) serveConnection(ctx context.Context, conn *transport.Transport, so socket.Opts, acceptor ServerAcceptor) {
+	raw, setup, err := socket.NewDuplexRSocket(conn, nil)
+	if err != nil {
+		_ = conn.Close()
+		return
+	}
+	if token, ok := setup.Token(); ok {
+		so.ResumeToken = token
+	}
+	sk := socket.NewServerSocket(raw, so)
+	responder, err := acceptor(setup, sk)
+	if err != nil {
+		_ = sk.Close()
+		return
+	}
+	sk.SetResponder(responder)
+	if err := sk.Start(ctx); err != nil {
+		_ = sk.Close()
+	}
+}